@@ -0,0 +1,174 @@
+package api
+
+// BranchProtectionRule describes the subset of a repository's branch
+// protection settings that `pr create`'s preflight check cares about.
+type BranchProtectionRule struct {
+	RequiredApprovingReviewCount int
+	RequiredStatusCheckContexts  []string
+	RequiresSignedCommits        bool
+	RequiresLinearHistory        bool
+	RestrictsPushes              bool
+}
+
+// BranchProtectionForRef looks up the branch protection rule that applies to
+// branch in baseRepo, if any.
+func BranchProtectionForRef(client *Client, baseRepo *Repository, branch string) (*BranchProtectionRule, error) {
+	query := `
+	query BranchProtectionRule($owner: String!, $repo: String!, $branch: String!) {
+		repository(owner: $owner, name: $repo) {
+			ref(qualifiedName: $branch) {
+				branchProtectionRule {
+					requiredApprovingReviewCount
+					requiredStatusCheckContexts
+					requiresCommitSignatures
+					requiresLinearHistory
+					restrictsPushes
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  baseRepo.RepoOwner(),
+		"repo":   baseRepo.RepoName(),
+		"branch": "refs/heads/" + branch,
+	}
+
+	result := struct {
+		Repository struct {
+			Ref struct {
+				BranchProtectionRule *struct {
+					RequiredApprovingReviewCount int
+					RequiredStatusCheckContexts  []string
+					RequiresCommitSignatures     bool
+					RequiresLinearHistory        bool
+					RestrictsPushes              bool
+				}
+			}
+		}
+	}{}
+
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	rule := result.Repository.Ref.BranchProtectionRule
+	if rule == nil {
+		return nil, nil
+	}
+
+	return &BranchProtectionRule{
+		RequiredApprovingReviewCount: rule.RequiredApprovingReviewCount,
+		RequiredStatusCheckContexts:  rule.RequiredStatusCheckContexts,
+		RequiresSignedCommits:        rule.RequiresCommitSignatures,
+		RequiresLinearHistory:        rule.RequiresLinearHistory,
+		RestrictsPushes:              rule.RestrictsPushes,
+	}, nil
+}
+
+// StatusCheckContexts returns the context names of the status checks and
+// check runs reported against the given ref's head commit.
+func StatusCheckContexts(client *Client, baseRepo *Repository, ref string) ([]string, error) {
+	query := `
+	query StatusCheckContexts($owner: String!, $repo: String!, $ref: String!) {
+		repository(owner: $owner, name: $repo) {
+			ref(qualifiedName: $ref) {
+				target {
+					... on Commit {
+						status {
+							contexts { context }
+						}
+						checkSuites(first: 100) {
+							nodes {
+								checkRuns(first: 100) {
+									nodes { name }
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": baseRepo.RepoOwner(),
+		"repo":  baseRepo.RepoName(),
+		"ref":   "refs/heads/" + ref,
+	}
+
+	result := struct {
+		Repository struct {
+			Ref struct {
+				Target struct {
+					Status struct {
+						Contexts []struct{ Context string }
+					}
+					CheckSuites struct {
+						Nodes []struct {
+							CheckRuns struct {
+								Nodes []struct{ Name string }
+							}
+						}
+					}
+				}
+			}
+		}
+	}{}
+
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	contexts := []string{}
+	for _, c := range result.Repository.Ref.Target.Status.Contexts {
+		contexts = append(contexts, c.Context)
+	}
+	for _, suite := range result.Repository.Ref.Target.CheckSuites.Nodes {
+		for _, run := range suite.CheckRuns.Nodes {
+			contexts = append(contexts, run.Name)
+		}
+	}
+
+	return contexts, nil
+}
+
+// CommitsAreSigned reports whether the head commit of ref has a verified
+// signature.
+func CommitsAreSigned(client *Client, baseRepo *Repository, ref string) (bool, error) {
+	query := `
+	query CommitSignature($owner: String!, $repo: String!, $ref: String!) {
+		repository(owner: $owner, name: $repo) {
+			ref(qualifiedName: $ref) {
+				target {
+					... on Commit {
+						signature { isValid }
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": baseRepo.RepoOwner(),
+		"repo":  baseRepo.RepoName(),
+		"ref":   "refs/heads/" + ref,
+	}
+
+	result := struct {
+		Repository struct {
+			Ref struct {
+				Target struct {
+					Signature *struct{ IsValid bool }
+				}
+			}
+		}
+	}{}
+
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return false, err
+	}
+
+	sig := result.Repository.Ref.Target.Signature
+	return sig != nil && sig.IsValid, nil
+}
@@ -0,0 +1,172 @@
+package api
+
+import "github.com/cli/cli/pkg/ghrepo"
+
+// PullRequest represents a GitHub pull request as surfaced to the CLI.
+type PullRequest struct {
+	ID      string
+	URL     string
+	Number  int
+	Title   string
+	State   string
+	IsDraft bool
+}
+
+// PullRequestsPayload is the set of inputs the `createPullRequest` mutation
+// accepts from `pr create`.
+type PullRequestsPayload struct {
+	Title       string
+	Body        string
+	BaseRefName string
+	HeadRefName string
+	Draft       bool
+}
+
+// RepoTemplate is a single pull request template discovered in a repo's
+// .github directory.
+type RepoTemplate struct {
+	Name string
+	Body string
+}
+
+// CreatePullRequest creates a new pull request in baseRepo via the
+// `createPullRequest` GraphQL mutation.
+func CreatePullRequest(client *Client, baseRepo *Repository, params *PullRequestsPayload) (*PullRequest, error) {
+	query := `
+	mutation CreatePullRequest($input: CreatePullRequestInput!) {
+		createPullRequest(input: $input) {
+			pullRequest {
+				id
+				url
+				number
+				isDraft
+			}
+		}
+	}`
+
+	inputParams := map[string]interface{}{
+		"repositoryId": baseRepo.ID,
+		"title":        params.Title,
+		"body":         params.Body,
+		"baseRefName":  params.BaseRefName,
+		"headRefName":  params.HeadRefName,
+	}
+	if params.Draft {
+		inputParams["draft"] = true
+	}
+	variables := map[string]interface{}{"input": inputParams}
+
+	result := struct {
+		CreatePullRequest struct {
+			PullRequest PullRequest
+		}
+	}{}
+
+	err := client.GraphQL(query, variables, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.CreatePullRequest.PullRequest, nil
+}
+
+// PullRequestList fetches the open pull requests for baseRepo, most recently
+// updated first.
+func PullRequestList(client *Client, baseRepo *Repository) ([]*PullRequest, error) {
+	query := `
+	query PullRequestList($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequests(states: OPEN, first: 100, orderBy: {field: UPDATED_AT, direction: DESC}) {
+				nodes {
+					id
+					url
+					number
+					title
+					isDraft
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": baseRepo.RepoOwner(),
+		"repo":  baseRepo.RepoName(),
+	}
+
+	result := struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []*PullRequest
+			}
+		}
+	}{}
+
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Repository.PullRequests.Nodes, nil
+}
+
+// RepoPullRequestTemplates looks up the pull request template(s) configured
+// for baseRepo, either the single `.github/PULL_REQUEST_TEMPLATE.md` file or
+// the contents of `.github/PULL_REQUEST_TEMPLATE/`.
+func RepoPullRequestTemplates(client *Client, baseRepo ghrepo.Interface) ([]RepoTemplate, error) {
+	query := `
+	query PullRequestTemplates($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequestTemplates: object(expression: "HEAD:.github/PULL_REQUEST_TEMPLATE") {
+				... on Tree {
+					entries {
+						name
+						object {
+							... on Blob {
+								text
+							}
+						}
+					}
+				}
+			}
+			singleTemplate: object(expression: "HEAD:.github/PULL_REQUEST_TEMPLATE.md") {
+				... on Blob {
+					text
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": baseRepo.RepoOwner(),
+		"repo":  baseRepo.RepoName(),
+	}
+
+	result := struct {
+		Repository struct {
+			PullRequestTemplates struct {
+				Entries []struct {
+					Name   string
+					Object struct {
+						Text string
+					}
+				}
+			}
+			SingleTemplate struct {
+				Text string
+			}
+		}
+	}{}
+
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	templates := []RepoTemplate{}
+	for _, entry := range result.Repository.PullRequestTemplates.Entries {
+		templates = append(templates, RepoTemplate{Name: entry.Name, Body: entry.Object.Text})
+	}
+	if len(templates) == 0 && result.Repository.SingleTemplate.Text != "" {
+		templates = append(templates, RepoTemplate{Name: "PULL_REQUEST_TEMPLATE.md", Body: result.Repository.SingleTemplate.Text})
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,311 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PullRequestMetadataInput carries the human-friendly names a user passed to
+// `pr create` for reviewers, assignees, labels, a milestone, and projects.
+type PullRequestMetadataInput struct {
+	Reviewers []string
+	Assignees []string
+	Labels    []string
+	Milestone string
+	Projects  []string
+}
+
+// AddMetadataToPullRequest resolves the names in input to their GraphQL node
+// IDs within baseRepo and attaches them to pr via a series of follow-up
+// mutations, since `createPullRequest` itself only accepts a title, body,
+// base/head refs, and a draft flag.
+func AddMetadataToPullRequest(client *Client, baseRepo *Repository, pr *PullRequest, input PullRequestMetadataInput) error {
+	metadata, err := resolvePullRequestMetadataIDs(client, baseRepo, input)
+	if err != nil {
+		return err
+	}
+
+	if len(metadata.userReviewerIDs) > 0 || len(metadata.teamReviewerIDs) > 0 {
+		if err := mutateRequestReviews(client, pr, metadata.userReviewerIDs, metadata.teamReviewerIDs); err != nil {
+			return err
+		}
+	}
+	if len(metadata.assigneeIDs) > 0 {
+		if err := mutateAddAssignees(client, pr, metadata.assigneeIDs); err != nil {
+			return err
+		}
+	}
+	if len(metadata.labelIDs) > 0 {
+		if err := mutateAddLabels(client, pr, metadata.labelIDs); err != nil {
+			return err
+		}
+	}
+	if metadata.milestoneID != "" {
+		if err := mutateSetMilestone(client, pr, metadata.milestoneID); err != nil {
+			return err
+		}
+	}
+	for _, projectColumnID := range metadata.projectColumnIDs {
+		if err := mutateAddProjectCard(client, pr, projectColumnID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type resolvedMetadata struct {
+	userReviewerIDs  []string
+	teamReviewerIDs  []string
+	assigneeIDs      []string
+	labelIDs         []string
+	milestoneID      string
+	projectColumnIDs []string
+}
+
+// resolvePullRequestMetadataIDs issues a single batched GraphQL query against
+// baseRepo to translate every reviewer/assignee/label/milestone/project name
+// the user passed into the node IDs the follow-up mutations need.
+func resolvePullRequestMetadataIDs(client *Client, baseRepo *Repository, input PullRequestMetadataInput) (*resolvedMetadata, error) {
+	result := &resolvedMetadata{}
+
+	var userLogins, teamSlugs []string
+	for _, r := range input.Reviewers {
+		if strings.Contains(r, "/") {
+			teamSlugs = append(teamSlugs, r)
+		} else {
+			userLogins = append(userLogins, r)
+		}
+	}
+
+	if len(userLogins) == 0 && len(teamSlugs) == 0 && len(input.Assignees) == 0 &&
+		len(input.Labels) == 0 && input.Milestone == "" && len(input.Projects) == 0 {
+		return result, nil
+	}
+
+	// The organization/teams selection only resolves on org-owned repos; a
+	// personal repo has no $owner organization, and GitHub errors out the
+	// whole query if we ask for it. Only request it when there's a team
+	// reviewer to resolve.
+	organizationFragment := ""
+	if len(teamSlugs) > 0 {
+		organizationFragment = `
+		organization(login: $owner) {
+			teams(first: 100) { nodes { id slug } }
+		}`
+	}
+
+	query := fmt.Sprintf(`
+	query PullRequestMetadata($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			labels(first: 100) { nodes { id name } }
+			milestones(first: 100) { nodes { id title } }
+			projects(first: 100) { nodes { id name columns(first: 100) { nodes { id name } } } }
+			assignableUsers(first: 100) { nodes { id login } }
+		}
+		%s
+	}`, organizationFragment)
+
+	variables := map[string]interface{}{
+		"owner": baseRepo.RepoOwner(),
+		"repo":  baseRepo.RepoName(),
+	}
+
+	response := struct {
+		Repository struct {
+			Labels struct {
+				Nodes []struct{ ID, Name string }
+			}
+			Milestones struct {
+				Nodes []struct{ ID, Title string }
+			}
+			Projects struct {
+				Nodes []struct {
+					ID      string
+					Name    string
+					Columns struct {
+						Nodes []struct{ ID, Name string }
+					}
+				}
+			}
+			AssignableUsers struct {
+				Nodes []struct{ ID, Login string }
+			}
+		}
+		Organization struct {
+			Teams struct {
+				Nodes []struct{ ID, Slug string }
+			}
+		}
+	}{}
+
+	if err := client.GraphQL(query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	unresolved := []string{}
+
+	for _, login := range userLogins {
+		found := false
+		for _, u := range response.Repository.AssignableUsers.Nodes {
+			if u.Login == login {
+				result.userReviewerIDs = append(result.userReviewerIDs, u.ID)
+				found = true
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, fmt.Sprintf("reviewer %q", login))
+		}
+	}
+
+	for _, slug := range teamSlugs {
+		name := strings.SplitN(slug, "/", 2)[1]
+		found := false
+		for _, team := range response.Organization.Teams.Nodes {
+			if team.Slug == name {
+				result.teamReviewerIDs = append(result.teamReviewerIDs, team.ID)
+				found = true
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, fmt.Sprintf("reviewer %q", slug))
+		}
+	}
+
+	for _, login := range input.Assignees {
+		found := false
+		for _, u := range response.Repository.AssignableUsers.Nodes {
+			if u.Login == login {
+				result.assigneeIDs = append(result.assigneeIDs, u.ID)
+				found = true
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, fmt.Sprintf("assignee %q", login))
+		}
+	}
+
+	for _, name := range input.Labels {
+		found := false
+		for _, l := range response.Repository.Labels.Nodes {
+			if l.Name == name {
+				result.labelIDs = append(result.labelIDs, l.ID)
+				found = true
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, fmt.Sprintf("label %q", name))
+		}
+	}
+
+	if input.Milestone != "" {
+		found := false
+		for _, m := range response.Repository.Milestones.Nodes {
+			if m.Title == input.Milestone {
+				result.milestoneID = m.ID
+				found = true
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, fmt.Sprintf("milestone %q", input.Milestone))
+		}
+	}
+
+	for _, name := range input.Projects {
+		found := false
+		for _, p := range response.Repository.Projects.Nodes {
+			if p.Name != name || len(p.Columns.Nodes) == 0 {
+				continue
+			}
+			result.projectColumnIDs = append(result.projectColumnIDs, p.Columns.Nodes[0].ID)
+			found = true
+		}
+		if !found {
+			unresolved = append(unresolved, fmt.Sprintf("project %q", name))
+		}
+	}
+
+	if len(unresolved) > 0 {
+		return nil, fmt.Errorf("could not resolve: %s", strings.Join(unresolved, ", "))
+	}
+
+	return result, nil
+}
+
+func mutateRequestReviews(client *Client, pr *PullRequest, userIDs, teamIDs []string) error {
+	query := `
+	mutation RequestReviews($input: RequestReviewsInput!) {
+		requestReviews(input: $input) { clientMutationId }
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"pullRequestId": pr.ID,
+			"userIds":       userIDs,
+			"teamIds":       teamIDs,
+			"union":         true,
+		},
+	}
+	result := struct{}{}
+	return client.GraphQL(query, variables, &result)
+}
+
+func mutateAddAssignees(client *Client, pr *PullRequest, assigneeIDs []string) error {
+	query := `
+	mutation AddAssignees($input: AddAssigneesToAssignableInput!) {
+		addAssigneesToAssignable(input: $input) { clientMutationId }
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"assignableId": pr.ID,
+			"assigneeIds":  assigneeIDs,
+		},
+	}
+	result := struct{}{}
+	return client.GraphQL(query, variables, &result)
+}
+
+func mutateAddLabels(client *Client, pr *PullRequest, labelIDs []string) error {
+	query := `
+	mutation AddLabels($input: AddLabelsToLabelableInput!) {
+		addLabelsToLabelable(input: $input) { clientMutationId }
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"labelableId": pr.ID,
+			"labelIds":    labelIDs,
+		},
+	}
+	result := struct{}{}
+	return client.GraphQL(query, variables, &result)
+}
+
+func mutateSetMilestone(client *Client, pr *PullRequest, milestoneID string) error {
+	query := `
+	mutation SetMilestone($input: UpdateIssueInput!) {
+		updateIssue(input: $input) { clientMutationId }
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id":          pr.ID,
+			"milestoneId": milestoneID,
+		},
+	}
+	result := struct{}{}
+	return client.GraphQL(query, variables, &result)
+}
+
+func mutateAddProjectCard(client *Client, pr *PullRequest, projectColumnID string) error {
+	query := `
+	mutation AddProjectCard($input: AddProjectCardInput!) {
+		addProjectCard(input: $input) { clientMutationId }
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"projectColumnId": projectColumnID,
+			"contentId":       pr.ID,
+		},
+	}
+	result := struct{}{}
+	return client.GraphQL(query, variables, &result)
+}
+
@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(prCmd)
+}
+
+var prCmd = &cobra.Command{
+	Use:   "pr",
+	Short: "Create and list pull requests",
+}
@@ -0,0 +1,387 @@
+package command
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/pkg/ghrepo"
+	"github.com/cli/cli/pkg/surveyext"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	prCreateActionSubmit  = "submit"
+	prCreateActionPreview = "preview"
+	prCreateActionCancel  = "cancel"
+)
+
+// surveyAsk is indirected so tests can script prompt answers.
+var surveyAsk = survey.Ask
+
+func init() {
+	prCreateCmd.Flags().BoolP("web", "w", false, "Open the web browser to create a pull request")
+	prCreateCmd.Flags().StringP("title", "t", "", "Title for the pull request")
+	prCreateCmd.Flags().StringP("body", "b", "", "Body for the pull request")
+	prCreateCmd.Flags().StringP("base", "B", "", "The branch into which you want your code merged")
+	prCreateCmd.Flags().Bool("fill", false, "Do not prompt for title/body and just use commit info")
+	prCreateCmd.Flags().Bool("fill-verbose", false, "Like --fill, but include all commit messages in the body")
+	prCreateCmd.Flags().BoolP("draft", "d", false, "Mark pull request as a draft")
+	prCreateCmd.Flags().StringSliceP("reviewer", "r", nil, "Request reviews from people or teams by their `login`")
+	prCreateCmd.Flags().StringSliceP("assignee", "a", nil, "Assign people by their `login`")
+	prCreateCmd.Flags().StringSliceP("label", "l", nil, "Add labels by `name`")
+	prCreateCmd.Flags().StringP("milestone", "m", "", "Add the pull request to a milestone by `name`")
+	prCreateCmd.Flags().StringSliceP("project", "p", nil, "Add the pull request to projects by `name`")
+	prCreateCmd.Flags().Bool("no-preflight", false, "Skip the branch protection and status check preview")
+
+	prCmd.AddCommand(prCreateCmd)
+}
+
+var prCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a pull request",
+	RunE:  prCreate,
+}
+
+func prCreate(cmd *cobra.Command, _ []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	baseBranch, err := cmd.Flags().GetString("base")
+	if err != nil {
+		return err
+	}
+	if baseBranch == "" {
+		baseBranch = baseRepo.DefaultBranchRef.Name
+	}
+
+	headBranch, err := ctx.Branch()
+	if err != nil {
+		return fmt.Errorf("could not determine current branch: %w", err)
+	}
+	headBranchLabel := headBranch
+	if headRepo, err := determineHeadRepo(ctx, baseRepo); err == nil && !ghrepo.IsSame(headRepo, baseRepo) {
+		headBranchLabel = fmt.Sprintf("%s:%s", ghrepo.RepoOwner(headRepo), headBranch)
+	}
+
+	title, err := cmd.Flags().GetString("title")
+	if err != nil {
+		return err
+	}
+	body, err := cmd.Flags().GetString("body")
+	if err != nil {
+		return err
+	}
+	fill, err := cmd.Flags().GetBool("fill")
+	if err != nil {
+		return err
+	}
+	fillVerbose, err := cmd.Flags().GetBool("fill-verbose")
+	if err != nil {
+		return err
+	}
+	web, err := cmd.Flags().GetBool("web")
+	if err != nil {
+		return err
+	}
+	isDraft, err := cmd.Flags().GetBool("draft")
+	if err != nil {
+		return err
+	}
+
+	if (fill || fillVerbose) && (title != "" || body != "") {
+		return fmt.Errorf("`--fill`/`--fill-verbose` is not supported alongside `-t`/`-b`")
+	}
+
+	if fill || fillVerbose {
+		title, body, err = fillTitleAndBody(baseBranch, headBranch, fillVerbose)
+		if err != nil {
+			return fmt.Errorf("could not compute title/body defaults: %w", err)
+		}
+	}
+
+	if ucc, err := git.UncommittedChangeCount(); err == nil && ucc > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n\n", utils.Pluralize(ucc, "uncommitted change"))
+	}
+
+	templateName := ""
+	isTTY := !web && !fill && !fillVerbose && title == "" && body == "" && utils.IsTerminal(cmd.InOrStdin())
+
+	if web {
+		openURL := fmt.Sprintf("https://github.com/%s/compare/%s...%s?expand=1", ghrepo.FullName(baseRepo), baseBranch, headBranchLabel)
+		if title != "" {
+			openURL += fmt.Sprintf("&title=%s", url.QueryEscape(title))
+		}
+		if body != "" {
+			openURL += fmt.Sprintf("&body=%s", url.QueryEscape(body))
+		}
+		// With exactly one pull request template configured, surface it the
+		// same way the interactive flow's "Continue in browser" action does.
+		// With more than one, there's no prompt to choose from on this
+		// non-interactive path, so leave it to GitHub's own compare UI.
+		if templates, err := api.RepoPullRequestTemplates(client, baseRepo); err == nil && len(templates) == 1 {
+			openURL += fmt.Sprintf("&template=%s", url.QueryEscape(templates[0].Name))
+		}
+		if isDraft {
+			openURL += "&draft=1"
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+		return utils.OpenInBrowser(openURL)
+	}
+
+	if isTTY {
+		var action string
+		title, body, templateName, action, err = prInteractive(cmd, client, baseRepo, title, body)
+		if err != nil {
+			return err
+		}
+		if action == prCreateActionCancel {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Discarding pull request.")
+			return nil
+		}
+		if action == prCreateActionPreview {
+			openURL := fmt.Sprintf("https://github.com/%s/compare/%s...%s?expand=1", ghrepo.FullName(baseRepo), baseBranch, headBranchLabel)
+			if title != "" {
+				openURL += fmt.Sprintf("&title=%s", url.QueryEscape(title))
+			}
+			if body != "" {
+				openURL += fmt.Sprintf("&body=%s", url.QueryEscape(body))
+			}
+			if templateName != "" {
+				openURL += fmt.Sprintf("&template=%s", url.QueryEscape(templateName))
+			}
+			if isDraft {
+				openURL += "&draft=1"
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+			return utils.OpenInBrowser(openURL)
+		}
+	}
+
+	if title == "" {
+		return fmt.Errorf("pull request title must be specified when not running interactively")
+	}
+
+	if err := prPreflight(cmd, client, baseRepo, baseBranch, headBranch); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Creating pull request for %s into %s in %s\n\n", headBranchLabel, baseBranch, ghrepo.FullName(baseRepo))
+
+	input := api.PullRequestsPayload{
+		Title:       title,
+		Body:        body,
+		BaseRefName: baseBranch,
+		HeadRefName: headBranchLabel,
+		Draft:       isDraft,
+	}
+
+	pr, err := api.CreatePullRequest(client, baseRepo, &input)
+	if err != nil {
+		return fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	reviewers, err := cmd.Flags().GetStringSlice("reviewer")
+	if err != nil {
+		return err
+	}
+	assignees, err := cmd.Flags().GetStringSlice("assignee")
+	if err != nil {
+		return err
+	}
+	labels, err := cmd.Flags().GetStringSlice("label")
+	if err != nil {
+		return err
+	}
+	milestone, err := cmd.Flags().GetString("milestone")
+	if err != nil {
+		return err
+	}
+	projects, err := cmd.Flags().GetStringSlice("project")
+	if err != nil {
+		return err
+	}
+
+	if len(reviewers) > 0 || len(assignees) > 0 || len(labels) > 0 || milestone != "" || len(projects) > 0 {
+		err = api.AddMetadataToPullRequest(client, baseRepo, pr, api.PullRequestMetadataInput{
+			Reviewers: reviewers,
+			Assignees: assignees,
+			Labels:    labels,
+			Milestone: milestone,
+			Projects:  projects,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update pull request metadata: %w", err)
+		}
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), pr.URL)
+	return nil
+}
+
+// prInteractive prompts the user for a title, lets them pick a pull request
+// template (if the base repo has one) to seed the body, opens an editor to
+// finalize the body, and asks how to proceed.
+func prInteractive(cmd *cobra.Command, client *api.Client, baseRepo ghrepo.Interface, defaultTitle, defaultBody string) (title, body, templateName, action string, err error) {
+	qs := []*survey.Question{
+		{
+			Name:     "title",
+			Prompt:   &survey.Input{Message: "Title", Default: defaultTitle},
+			Validate: survey.Required,
+		},
+	}
+	titleAnswer := struct{ Title string }{}
+	if err = surveyAsk(qs, &titleAnswer); err != nil {
+		return
+	}
+	title = titleAnswer.Title
+
+	bodyDefault := defaultBody
+	if templateName, bodyDefault, err = prSelectTemplate(client, baseRepo, bodyDefault); err != nil {
+		return
+	}
+
+	bodyAnswer := struct{ Body string }{}
+	err = surveyAsk([]*survey.Question{
+		{
+			Name: "body",
+			Prompt: &surveyext.GhEditor{
+				Editor: &survey.Editor{
+					Message:       "Body",
+					FileName:      "*.md",
+					Default:       bodyDefault,
+					AppendDefault: true,
+				},
+			},
+		},
+	}, &bodyAnswer)
+	if err != nil {
+		return
+	}
+	body = bodyAnswer.Body
+
+	confirmAnswer := struct{ Action string }{}
+	err = surveyAsk([]*survey.Question{
+		{
+			Name: "action",
+			Prompt: &survey.Select{
+				Message: "What's next?",
+				Options: []string{"Submit", "Continue in browser", "Cancel"},
+			},
+		},
+	}, &confirmAnswer)
+	if err != nil {
+		return
+	}
+
+	switch confirmAnswer.Action {
+	case "Continue in browser":
+		action = prCreateActionPreview
+	case "Cancel":
+		action = prCreateActionCancel
+	default:
+		action = prCreateActionSubmit
+	}
+	return
+}
+
+// prSelectTemplate looks up the pull request templates available in the
+// base repo and, if more than one is found, asks the user to choose. It
+// returns the chosen template's name (for surfacing on the compare URL when
+// the user picks "Continue in browser") and
+// its contents, falling back to fallbackBody when there is no template.
+func prSelectTemplate(client *api.Client, baseRepo ghrepo.Interface, fallbackBody string) (string, string, error) {
+	templates, err := api.RepoPullRequestTemplates(client, baseRepo)
+	if err != nil || len(templates) == 0 {
+		return "", fallbackBody, nil
+	}
+
+	if len(templates) == 1 {
+		return templates[0].Name, templates[0].Body, nil
+	}
+
+	blankOption := "Open a blank pull request"
+	options := make([]string, 0, len(templates)+1)
+	for _, t := range templates {
+		options = append(options, t.Name)
+	}
+	options = append(options, blankOption)
+
+	answer := struct{ Template string }{}
+	err = surveyAsk([]*survey.Question{
+		{
+			Name: "template",
+			Prompt: &survey.Select{
+				Message: "Choose a template",
+				Options: options,
+			},
+		},
+	}, &answer)
+	if err != nil {
+		return "", fallbackBody, err
+	}
+
+	for _, t := range templates {
+		if t.Name == answer.Template {
+			return t.Name, t.Body, nil
+		}
+	}
+	return "", fallbackBody, nil
+}
+
+// determineHeadRepo figures out which repository the current branch lives on
+// from the user's perspective, which is baseRepo unless a fork remote is
+// configured for pushing.
+func determineHeadRepo(ctx context.Context, baseRepo ghrepo.Interface) (ghrepo.Interface, error) {
+	remotes, err := ctx.Remotes()
+	if err != nil {
+		return baseRepo, err
+	}
+	for _, r := range remotes {
+		if r.Name == "fork" {
+			return r, nil
+		}
+	}
+	return baseRepo, nil
+}
+
+// fillTitleAndBody derives a title and body for the pull request from the
+// commits between base and head, for use with --fill/--fill-verbose.
+func fillTitleAndBody(baseBranch, headBranch string, verbose bool) (string, string, error) {
+	commits, err := git.Commits(baseBranch, headBranch)
+	if err != nil {
+		return "", "", err
+	}
+	if len(commits) == 0 {
+		return "", "", fmt.Errorf("no commits found between %s and %s", baseBranch, headBranch)
+	}
+
+	if len(commits) == 1 {
+		return commits[0].Title, commits[0].Body, nil
+	}
+
+	title := headBranch
+	var body strings.Builder
+	for _, c := range commits {
+		if verbose {
+			body.WriteString(fmt.Sprintf("- %s\n\n%s\n\n", c.Title, c.Body))
+		} else {
+			body.WriteString(fmt.Sprintf("- %s\n", c.Title))
+		}
+	}
+
+	return title, strings.TrimSpace(body.String()), nil
+}
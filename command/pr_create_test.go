@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/cli/cli/context"
 	"github.com/cli/cli/git"
 	"github.com/cli/cli/test"
@@ -33,6 +34,18 @@ func TestPrCreateHelperProcess(*testing.T) {
 			os.Exit(1)
 		}
 	case "push":
+	case "log":
+		switch args[0] {
+		case "singleCommit":
+			fmt.Print("0123456789abcdef\x00the title of the commit, with a comma\x00the body of the commit\x00\n")
+		case "multipleCommits":
+			fmt.Print("aaa111\x00title one, with a comma\x00body one\x00\nbbb222\x00title two\x00body two\x00\n")
+		case "multilineBody":
+			fmt.Print("ccc333\x00title with a multi-line body\x00para one line A\npara one line B\x00\n")
+		default:
+			fmt.Fprintf(os.Stderr, "unknown scenario: %q", args[0])
+			os.Exit(1)
+		}
 	default:
 		fmt.Fprintf(os.Stderr, "unknown command: %q", args[1])
 		os.Exit(1)
@@ -44,6 +57,9 @@ func TestPRCreate(t *testing.T) {
 	initBlankContext("OWNER/REPO", "feature")
 	http := initFakeHTTP()
 	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
 	http.StubResponse(200, bytes.NewBufferString(`
 		{ "data": { "createPullRequest": { "pullRequest": {
 			"URL": "https://github.com/OWNER/REPO/pull/12"
@@ -59,7 +75,7 @@ func TestPRCreate(t *testing.T) {
 	output, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body"`)
 	eq(t, err, nil)
 
-	bodyBytes, _ := ioutil.ReadAll(http.Requests[1].Body)
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
 	reqBody := struct {
 		Variables struct {
 			Input struct {
@@ -86,6 +102,9 @@ func TestPRCreate_web(t *testing.T) {
 	initBlankContext("OWNER/REPO", "feature")
 	http := initFakeHTTP()
 	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequestTemplates": null, "singleTemplate": null } } }
+	`))
 
 	ranCommands := [][]string{}
 	restoreCmd := utils.SetPrepareCmd(func(cmd *exec.Cmd) utils.Runnable {
@@ -105,11 +124,35 @@ func TestPRCreate_web(t *testing.T) {
 	eq(t, ranCommands[2][len(ranCommands[2])-1], "https://github.com/OWNER/REPO/compare/master...feature?expand=1")
 }
 
+func TestPRCreate_web_singleTemplate(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "singleTemplate": { "text": "Fill this out" } } } }
+	`))
+
+	ranCommands := [][]string{}
+	restoreCmd := utils.SetPrepareCmd(func(cmd *exec.Cmd) utils.Runnable {
+		ranCommands = append(ranCommands, cmd.Args)
+		return &outputStub{}
+	})
+	defer restoreCmd()
+
+	_, err := RunCommand(prCreateCmd, `pr create --web`)
+	eq(t, err, nil)
+
+	eq(t, strings.Contains(ranCommands[2][len(ranCommands[2])-1], "&template=PULL_REQUEST_TEMPLATE.md"), true)
+}
+
 func TestPRCreate_ReportsUncommittedChanges(t *testing.T) {
 	initBlankContext("OWNER/REPO", "feature")
 	http := initFakeHTTP()
 
 	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
 	http.StubResponse(200, bytes.NewBufferString(`
 		{ "data": { "createPullRequest": { "pullRequest": {
 			"URL": "https://github.com/OWNER/REPO/pull/12"
@@ -132,6 +175,478 @@ Creating pull request for feature into master in OWNER/REPO
 
 `)
 }
+func TestPRCreate_fill_singleCommit(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "singleCommit")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create --fill`)
+	eq(t, err, nil)
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Title string
+				Body  string
+			}
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.Input.Title, "the title of the commit, with a comma")
+	eq(t, reqBody.Variables.Input.Body, "the body of the commit")
+
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+}
+
+func TestPRCreate_fill_multilineBody(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "multilineBody")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create --fill`)
+	eq(t, err, nil)
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Title string
+				Body  string
+			}
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.Input.Title, "title with a multi-line body")
+	if !strings.Contains(reqBody.Variables.Input.Body, "para one line A") ||
+		!strings.Contains(reqBody.Variables.Input.Body, "para one line B") {
+		t.Errorf("expected body to contain both lines of the commit body, got: %q", reqBody.Variables.Input.Body)
+	}
+
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+}
+
+func TestPRCreate_fillVerbose_multipleCommits(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "multipleCommits")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create --fill-verbose`)
+	eq(t, err, nil)
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Title string
+				Body  string
+			}
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.Input.Title, "feature")
+	if !strings.Contains(reqBody.Variables.Input.Body, "title one") ||
+		!strings.Contains(reqBody.Variables.Input.Body, "body one") ||
+		!strings.Contains(reqBody.Variables.Input.Body, "title two") ||
+		!strings.Contains(reqBody.Variables.Input.Body, "body two") {
+		t.Errorf("expected body to contain all commit subjects and bodies, got: %q", reqBody.Variables.Input.Body)
+	}
+
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+}
+
+func TestPRCreate_fill_errorsWithTitleFlag(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	initFakeHTTP()
+
+	_, err := RunCommand(prCreateCmd, `pr create --fill -t "my title"`)
+	if err == nil {
+		t.Fatal("expected an error when combining --fill with -t")
+	}
+}
+
+func TestPRCreate_draft(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body" -d`)
+	eq(t, err, nil)
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Draft bool
+			}
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.Input.Draft, true)
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+}
+
+func TestPRCreate_web_draft(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequestTemplates": null, "singleTemplate": null } } }
+	`))
+
+	ranCommands := [][]string{}
+	restoreCmd := utils.SetPrepareCmd(func(cmd *exec.Cmd) utils.Runnable {
+		ranCommands = append(ranCommands, cmd.Args)
+		return &outputStub{}
+	})
+	defer restoreCmd()
+
+	output, err := RunCommand(prCreateCmd, `pr create --web --draft`)
+	eq(t, err, nil)
+
+	eq(t, output.String(), "")
+	eq(t, strings.HasSuffix(ranCommands[2][len(ranCommands[2])-1], "&draft=1"), true)
+}
+
+func TestPRCreate_preflight_unprotectedBase(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body"`)
+	eq(t, err, nil)
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+	eq(t, strings.Contains(output.Stderr(), "Branch protection"), false)
+}
+
+func TestPRCreate_preflight_missingRequiredCheck_nonTTY(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": {
+			"requiredApprovingReviewCount": 1,
+			"requiredStatusCheckContexts": ["ci/build"],
+			"requiresCommitSignatures": false,
+			"requiresLinearHistory": false,
+			"restrictsPushes": false
+		} } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "target": { "status": { "contexts": [] }, "checkSuites": { "nodes": [] } } } } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	_, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body"`)
+	if err == nil {
+		t.Fatal("expected an error aborting due to the missing required check")
+	}
+}
+
+func TestPRCreate_preflight_noPreflightSkipsCheck(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body" --no-preflight`)
+	eq(t, err, nil)
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+}
+
+func TestPRCreate_metadata(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"id": "PRID",
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": {
+			"repository": {
+				"labels": { "nodes": [{ "id": "LABELID", "name": "bug" }] },
+				"milestones": { "nodes": [{ "id": "MILESTONEID", "title": "1.0" }] },
+				"projects": { "nodes": [{ "id": "PROJECTID", "name": "Roadmap", "columns": { "nodes": [{ "id": "COLUMNID", "name": "To do" }] } }] },
+				"assignableUsers": { "nodes": [{ "id": "USERID", "login": "hubot" }] }
+			},
+			"organization": { "teams": { "nodes": [{ "id": "TEAMID", "slug": "backend" }] } }
+		} }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "requestReviews": { "clientMutationId": "" } } }`))
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "addAssigneesToAssignable": { "clientMutationId": "" } } }`))
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "addLabelsToLabelable": { "clientMutationId": "" } } }`))
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "updateIssue": { "clientMutationId": "" } } }`))
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "addProjectCard": { "clientMutationId": "" } } }`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body" -r hubot -r myorg/backend -a hubot -l bug -m 1.0 -p Roadmap`)
+	eq(t, err, nil)
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+
+	reqReview := struct {
+		Variables struct {
+			Input struct {
+				PullRequestID string `json:"pullRequestId"`
+				UserIds       []string
+				TeamIds       []string
+			}
+		}
+	}{}
+	reviewBytes, _ := ioutil.ReadAll(http.Requests[4].Body)
+	json.Unmarshal(reviewBytes, &reqReview)
+	eq(t, len(reqReview.Variables.Input.UserIds), 1)
+	eq(t, len(reqReview.Variables.Input.TeamIds), 1)
+}
+
+func TestPRCreate_metadata_unresolvedLabel(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"id": "PRID",
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": {
+			"repository": {
+				"labels": { "nodes": [{ "id": "LABELID", "name": "bug" }] },
+				"milestones": { "nodes": [] },
+				"projects": { "nodes": [] },
+				"assignableUsers": { "nodes": [] }
+			},
+			"organization": { "teams": { "nodes": [] } }
+		} }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	_, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body" -l bugg`)
+	if err == nil {
+		t.Fatal("expected an error for an unresolved label name")
+	}
+	if !strings.Contains(err.Error(), `"bugg"`) {
+		t.Errorf("expected error to name the unresolved label, got: %v", err)
+	}
+}
+
+func TestPRCreate_metadata_noTeamReviewer(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"id": "PRID",
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": {
+			"repository": {
+				"labels": { "nodes": [{ "id": "LABELID", "name": "bug" }] },
+				"milestones": { "nodes": [] },
+				"projects": { "nodes": [] },
+				"assignableUsers": { "nodes": [] }
+			}
+		} }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "addLabelsToLabelable": { "clientMutationId": "" } } }`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	_, err := RunCommand(prCreateCmd, `pr create -t "my title" -b "my body" -l bug`)
+	eq(t, err, nil)
+
+	resolveBytes, _ := ioutil.ReadAll(http.Requests[3].Body)
+	reqBody := struct {
+		Query string
+	}{}
+	json.Unmarshal(resolveBytes, &reqBody)
+	eq(t, strings.Contains(reqBody.Query, "organization"), false)
+}
+
+func TestPRCreate_interactive(t *testing.T) {
+	initBlankContext("OWNER/REPO", "feature")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequestTemplates": null, "singleTemplate": null } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+	`))
+
+	origGitCommand := git.GitCommand
+	git.GitCommand = test.StubExecCommand("TestPrCreateHelperProcess", "clean")
+	defer func() {
+		git.GitCommand = origGitCommand
+	}()
+
+	origSurveyAsk := surveyAsk
+	answers := []string{"interactive title", "interactive body", "Submit"}
+	callCount := 0
+	surveyAsk = func(qs []*survey.Question, out interface{}, _ ...survey.AskOpt) error {
+		switch v := out.(type) {
+		case *struct{ Title string }:
+			v.Title = answers[0]
+		case *struct{ Body string }:
+			v.Body = answers[1]
+		case *struct{ Action string }:
+			v.Action = answers[2]
+		}
+		callCount++
+		return nil
+	}
+	defer func() {
+		surveyAsk = origSurveyAsk
+	}()
+
+	oldIsTerminal := utils.IsTerminal
+	utils.IsTerminal = func(interface{}) bool { return true }
+	defer func() {
+		utils.IsTerminal = oldIsTerminal
+	}()
+
+	output, err := RunCommand(prCreateCmd, `pr create`)
+	eq(t, err, nil)
+	eq(t, callCount, 3)
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[3].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Title string
+				Body  string
+			}
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.Input.Title, "interactive title")
+	eq(t, reqBody.Variables.Input.Body, "interactive body")
+	eq(t, output.String(), "https://github.com/OWNER/REPO/pull/12\n")
+}
+
 func TestPRCreate_cross_repo_same_branch(t *testing.T) {
 	ctx := context.NewBlank()
 	ctx.SetBranch("default")
@@ -175,6 +690,9 @@ func TestPRCreate_cross_repo_same_branch(t *testing.T) {
 									"viewerPermission": "WRITE"
 		} } }
 	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "ref": { "branchProtectionRule": null } } } }
+	`))
 	http.StubResponse(200, bytes.NewBufferString(`
 		{ "data": { "createPullRequest": { "pullRequest": {
 			"URL": "https://github.com/OWNER/REPO/pull/12"
@@ -190,7 +708,7 @@ func TestPRCreate_cross_repo_same_branch(t *testing.T) {
 	output, err := RunCommand(prCreateCmd, `pr create -t "cross repo" -b "same branch"`)
 	eq(t, err, nil)
 
-	bodyBytes, _ := ioutil.ReadAll(http.Requests[1].Body)
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
 	reqBody := struct {
 		Variables struct {
 			Input struct {
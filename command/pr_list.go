@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	prCmd.AddCommand(prListCmd)
+}
+
+var prListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pull requests in this repository",
+	RunE:  prList,
+}
+
+func prList(cmd *cobra.Command, _ []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	prs, err := api.PullRequestList(client, baseRepo)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	for _, pr := range prs {
+		fmt.Fprintln(out, prListLine(pr))
+	}
+	return nil
+}
+
+// prListLine formats a single pull request for `pr list`, flagging drafts
+// so users can tell which of their PRs aren't ready for review yet. There
+// is no `pr status` command in this series to share the marker with; draft
+// status there is out of scope until that command exists.
+func prListLine(pr *api.PullRequest) string {
+	if pr.IsDraft {
+		return fmt.Sprintf("#%d %s [Draft]", pr.Number, pr.Title)
+	}
+	return fmt.Sprintf("#%d %s", pr.Number, pr.Title)
+}
@@ -0,0 +1,24 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/cli/cli/api"
+)
+
+func TestPrListLine_draft(t *testing.T) {
+	cases := []struct {
+		pr   *api.PullRequest
+		want string
+	}{
+		{&api.PullRequest{Number: 12, Title: "a feature", IsDraft: false}, "#12 a feature"},
+		{&api.PullRequest{Number: 13, Title: "a draft feature", IsDraft: true}, "#13 a draft feature [Draft]"},
+	}
+
+	for _, c := range cases {
+		got := prListLine(c.pr)
+		if got != c.want {
+			t.Errorf("prListLine() = %q, want %q", got, c.want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+// prPreflight inspects baseRepo's branch protection rule for baseBranch and
+// the status checks on headBranch's head commit, prints a short summary,
+// and asks for confirmation (or aborts in a non-TTY) when the protection
+// rule looks likely to reject the pull request outright.
+func prPreflight(cmd *cobra.Command, client *api.Client, baseRepo *api.Repository, baseBranch, headBranch string) error {
+	noPreflight, err := cmd.Flags().GetBool("no-preflight")
+	if err != nil {
+		return err
+	}
+	if noPreflight {
+		return nil
+	}
+
+	protection, err := api.BranchProtectionForRef(client, baseRepo, baseBranch)
+	if err != nil || protection == nil {
+		// Preflight is informational; don't block PR creation just because
+		// we couldn't introspect branch protection.
+		return nil
+	}
+
+	out := cmd.ErrOrStderr()
+	fmt.Fprintf(out, "Branch protection for %s:\n", baseBranch)
+	if protection.RequiredApprovingReviewCount > 0 {
+		fmt.Fprintf(out, "  - %d approving review(s) required\n", protection.RequiredApprovingReviewCount)
+	}
+	if len(protection.RequiredStatusCheckContexts) > 0 {
+		fmt.Fprintf(out, "  - required checks: %s\n", strings.Join(protection.RequiredStatusCheckContexts, ", "))
+	}
+	if protection.RestrictsPushes {
+		fmt.Fprintln(out, "  - merges into this branch are restricted")
+	}
+
+	if len(protection.RequiredStatusCheckContexts) > 0 {
+		if missing, err := missingStatusChecks(client, baseRepo, headBranch, protection.RequiredStatusCheckContexts); err == nil && len(missing) > 0 {
+			fmt.Fprintf(out, "Warning: required check(s) missing from %s: %s\n", headBranch, strings.Join(missing, ", "))
+			if err := prPreflightConfirm(cmd); err != nil {
+				return err
+			}
+		}
+	}
+
+	if protection.RequiresSignedCommits {
+		if signed, err := api.CommitsAreSigned(client, baseRepo, headBranch); err == nil && !signed {
+			fmt.Fprintln(out, "Warning: this branch requires signed commits, but the head commit is not signed")
+			if err := prPreflightConfirm(cmd); err != nil {
+				return err
+			}
+		}
+	}
+
+	if protection.RequiresLinearHistory {
+		if hasMergeCommits, err := git.HasMergeCommits(baseBranch, headBranch); err == nil && hasMergeCommits {
+			fmt.Fprintln(out, "Warning: this branch requires a linear history, but the branch contains merge commits")
+			if err := prPreflightConfirm(cmd); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func missingStatusChecks(client *api.Client, baseRepo *api.Repository, ref string, required []string) ([]string, error) {
+	present, err := api.StatusCheckContexts(client, baseRepo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	presentSet := map[string]bool{}
+	for _, p := range present {
+		presentSet[p] = true
+	}
+
+	missing := []string{}
+	for _, r := range required {
+		if !presentSet[r] {
+			missing = append(missing, r)
+		}
+	}
+	return missing, nil
+}
+
+func prPreflightConfirm(cmd *cobra.Command) error {
+	if !utils.IsTerminal(cmd.InOrStdin()) {
+		return fmt.Errorf("aborting due to branch protection warnings (use --no-preflight to skip this check)")
+	}
+
+	answer := struct{ Confirmed bool }{}
+	err := surveyAsk([]*survey.Question{
+		{
+			Name:   "confirmed",
+			Prompt: &survey.Confirm{Message: "Create the pull request anyway?"},
+		},
+	}, &answer)
+	if err != nil {
+		return err
+	}
+	if !answer.Confirmed {
+		return fmt.Errorf("pull request creation cancelled")
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/cli/cli/utils"
+)
+
+// GitCommand builds an *exec.Cmd for invoking git with the given args. It is
+// a variable so tests can stub it out.
+var GitCommand = func(args ...string) *exec.Cmd {
+	return exec.Command("git", args...)
+}
+
+// Commit represents a single git commit between two refs.
+type Commit struct {
+	Sha   string
+	Title string
+	Body  string
+}
+
+// commitFieldSep separates the sha/subject/body fields emitted by `git log`
+// below, and also terminates each commit's record. It can't appear in any
+// of those fields, unlike a comma or a newline (which shows up in bodies).
+const commitFieldSep = "\x00"
+
+// Commits returns the list of commits between baseRef and headRef, oldest
+// first, as reported by `git log`.
+func Commits(baseRef, headRef string) ([]*Commit, error) {
+	logCmd := GitCommand(
+		"log", "--reverse", "--pretty=format:%H"+commitFieldSep+"%s"+commitFieldSep+"%b"+commitFieldSep,
+		"--cherry", fmt.Sprintf("%s...%s", baseRef, headRef))
+	output, err := utils.PrepareCmd(logCmd).Output()
+	if err != nil {
+		return []*Commit{}, err
+	}
+
+	raw := string(output)
+	if raw == "" {
+		return []*Commit{}, nil
+	}
+
+	// Each record ends with commitFieldSep, and git joins records with a
+	// newline; trim the trailing empty field and the leading newline it
+	// leaves behind on every record after the first.
+	fields := strings.Split(raw, commitFieldSep)
+	fields = fields[:len(fields)-1]
+
+	commits := []*Commit{}
+	for i := 0; i+2 < len(fields); i += 3 {
+		commits = append(commits, &Commit{
+			Sha:   strings.TrimPrefix(fields[i], "\n"),
+			Title: fields[i+1],
+			Body:  fields[i+2],
+		})
+	}
+
+	return commits, nil
+}
+
+// UncommittedChangeCount counts the number of uncommitted changes in the
+// current git repository.
+func UncommittedChangeCount() (int, error) {
+	status, err := utils.PrepareCmd(GitCommand("status", "--porcelain")).Output()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, l := range outputLines(status) {
+		if l != "" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// HasMergeCommits reports whether any of the commits between baseRef and
+// headRef have more than one parent.
+func HasMergeCommits(baseRef, headRef string) (bool, error) {
+	mergeBaseCmd := GitCommand(
+		"log", "--merges", "--pretty=format:%H",
+		fmt.Sprintf("%s..%s", baseRef, headRef))
+	output, err := utils.PrepareCmd(mergeBaseCmd).Output()
+	if err != nil {
+		return false, err
+	}
+
+	return len(outputLines(output)) > 0, nil
+}
+
+func outputLines(output []byte) []string {
+	lines := strings.TrimSuffix(string(output), "\n")
+	if lines == "" {
+		return []string{}
+	}
+	return strings.Split(lines, "\n")
+}
@@ -0,0 +1,30 @@
+// Package surveyext extends the survey library with prompt types tailored
+// to gh's needs.
+package surveyext
+
+import (
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// GhEditor wraps survey's Editor question to resolve the editor binary the
+// same way the rest of gh does: $GIT_EDITOR, then $EDITOR, falling back to
+// survey's own default when neither is set.
+type GhEditor struct {
+	*survey.Editor
+}
+
+func (e *GhEditor) Prompt(config *survey.PromptConfig) (interface{}, error) {
+	if e.Editor.Editor == "" {
+		e.Editor.Editor = resolveEditor()
+	}
+	return e.Editor.Prompt(config)
+}
+
+func resolveEditor() string {
+	if editor := os.Getenv("GIT_EDITOR"); editor != "" {
+		return editor
+	}
+	return os.Getenv("EDITOR")
+}